@@ -0,0 +1,114 @@
+package cloudflare
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// dataSourceCloudflareZoneLockdown is the single-result counterpart to
+// dataSourceCloudflareZoneLockdowns: it takes the same filter attributes but
+// errors unless exactly one lockdown matches, so its attributes can be
+// referenced directly instead of indexing into a `lockdowns` list.
+func dataSourceCloudflareZoneLockdown() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCloudflareZoneLockdownRead,
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description_regex": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"url_contains": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"target": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"paused": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"urls": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"configurations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudflareZoneLockdownRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*cloudflareClient)
+
+	zoneID, err := zoneLockdownDataSourceZoneID(d, client)
+	if err != nil {
+		return err
+	}
+
+	filter, err := zoneLockdownFilterFromResourceData(d)
+	if err != nil {
+		return err
+	}
+
+	lockdowns, err := listMatchingZoneLockdowns(client, zoneID, filter)
+	if err != nil {
+		return err
+	}
+
+	if len(lockdowns) == 0 {
+		return fmt.Errorf("no zone lockdowns on zone %q matched the given filter", zoneID)
+	}
+	if len(lockdowns) > 1 {
+		return fmt.Errorf("filter on zone %q matched %d zone lockdowns; narrow the filter to match exactly one", zoneID, len(lockdowns))
+	}
+
+	lockdown := lockdowns[0]
+
+	d.SetId(lockdown.ID)
+	d.Set("paused", lockdown.Paused)
+	d.Set("description", lockdown.Description)
+	d.Set("urls", lockdown.URLs)
+
+	flattened := flattenZoneLockdown(lockdown)
+	if err := d.Set("configurations", flattened["configurations"]); err != nil {
+		return fmt.Errorf("error setting configurations: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,265 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceCloudflareZoneLockdowns() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCloudflareZoneLockdownsRead,
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description_regex": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"url_contains": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"target": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"paused": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"lockdowns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"paused": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"urls": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"configurations": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"target": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// zoneLockdownFilter holds the optional match criteria shared by the
+// `cloudflare_zone_lockdowns` and `cloudflare_zone_lockdown` data sources.
+type zoneLockdownFilter struct {
+	descriptionRegex *regexp.Regexp
+	urlContains      string
+	target           string
+	value            string
+	paused           *bool
+}
+
+func zoneLockdownFilterFromResourceData(d *schema.ResourceData) (*zoneLockdownFilter, error) {
+	filter := &zoneLockdownFilter{
+		urlContains: d.Get("url_contains").(string),
+		target:      d.Get("target").(string),
+		value:       d.Get("value").(string),
+	}
+
+	if raw, ok := d.GetOk("description_regex"); ok {
+		re, err := regexp.Compile(raw.(string))
+		if err != nil {
+			return nil, fmt.Errorf("description_regex (%q) is not a valid regular expression: %s", raw, err)
+		}
+		filter.descriptionRegex = re
+	}
+
+	if raw, ok := d.GetOkExists("paused"); ok {
+		paused := raw.(bool)
+		filter.paused = &paused
+	}
+
+	return filter, nil
+}
+
+func (f *zoneLockdownFilter) matches(lockdown cloudflare.ZoneLockdown) bool {
+	if f.descriptionRegex != nil && !f.descriptionRegex.MatchString(lockdown.Description) {
+		return false
+	}
+
+	if f.paused != nil && lockdown.Paused != *f.paused {
+		return false
+	}
+
+	if f.urlContains != "" {
+		found := false
+		for _, url := range lockdown.URLs {
+			if strings.Contains(url, f.urlContains) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if f.target != "" || f.value != "" {
+		found := false
+		for _, config := range lockdown.Configurations {
+			if f.target != "" && config.Target != f.target {
+				continue
+			}
+			if f.value != "" && config.Value != f.value {
+				continue
+			}
+			found = true
+			break
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// listMatchingZoneLockdowns pages through every lockdown on the zone and
+// returns the ones that satisfy filter.
+func listMatchingZoneLockdowns(client *cloudflareClient, zoneID string, filter *zoneLockdownFilter) ([]cloudflare.ZoneLockdown, error) {
+	var matches []cloudflare.ZoneLockdown
+
+	page := 1
+	for {
+		resp, err := client.ListZoneLockdowns(context.Background(), zoneID, page)
+		if err != nil {
+			return nil, fmt.Errorf("error listing zone lockdowns for zone %q: %s", zoneID, err)
+		}
+
+		for _, lockdown := range resp.Result {
+			if filter.matches(lockdown) {
+				matches = append(matches, lockdown)
+			}
+		}
+
+		if resp.ResultInfo.Page >= resp.ResultInfo.TotalPages {
+			break
+		}
+		page++
+	}
+
+	return matches, nil
+}
+
+func dataSourceCloudflareZoneLockdownsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*cloudflareClient)
+
+	zoneID, err := zoneLockdownDataSourceZoneID(d, client)
+	if err != nil {
+		return err
+	}
+
+	filter, err := zoneLockdownFilterFromResourceData(d)
+	if err != nil {
+		return err
+	}
+
+	lockdowns, err := listMatchingZoneLockdowns(client, zoneID, filter)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] found %d zone lockdowns matching filter on zone %q", len(lockdowns), zoneID)
+
+	flattened := make([]map[string]interface{}, len(lockdowns))
+	for i, lockdown := range lockdowns {
+		flattened[i] = flattenZoneLockdown(lockdown)
+	}
+
+	if err := d.Set("lockdowns", flattened); err != nil {
+		return fmt.Errorf("error setting lockdowns: %s", err)
+	}
+
+	d.SetId(zoneID)
+
+	return nil
+}
+
+func flattenZoneLockdown(lockdown cloudflare.ZoneLockdown) map[string]interface{} {
+	configurations := make([]map[string]interface{}, len(lockdown.Configurations))
+	for i, config := range lockdown.Configurations {
+		configurations[i] = map[string]interface{}{
+			"target": config.Target,
+			"value":  normalizeZoneLockdownConfigurationValue(config.Value),
+		}
+	}
+
+	return map[string]interface{}{
+		"id":             lockdown.ID,
+		"paused":         lockdown.Paused,
+		"description":    lockdown.Description,
+		"urls":           lockdown.URLs,
+		"configurations": configurations,
+	}
+}
+
+// zoneLockdownDataSourceZoneID resolves "zone"/"zone_id", the same pair of
+// lookup attributes resourceCloudflareZoneLockdown accepts.
+func zoneLockdownDataSourceZoneID(d *schema.ResourceData, client *cloudflareClient) (string, error) {
+	if zoneID, ok := d.GetOk("zone_id"); ok {
+		return zoneID.(string), nil
+	}
+
+	zone, ok := d.GetOk("zone")
+	if !ok {
+		return "", fmt.Errorf("one of zone or zone_id must be set")
+	}
+
+	zoneID, err := client.ZoneIDByName(zone.(string))
+	if err != nil {
+		return "", fmt.Errorf("error finding zone %q: %s", zone, err)
+	}
+
+	return zoneID, nil
+}
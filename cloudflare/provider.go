@@ -0,0 +1,88 @@
+package cloudflare
+
+import (
+	"fmt"
+	"time"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Provider returns a terraform.ResourceProvider (via schema.Provider) for
+// Cloudflare, configured from the `cloudflare` provider block.
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDFLARE_EMAIL", nil),
+			},
+			"api_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDFLARE_API_KEY", nil),
+			},
+			"api_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDFLARE_API_TOKEN", nil),
+			},
+			"max_retries": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     3,
+				Description: "Maximum number of retries to perform when an API request fails with a transient error (HTTP 429 or 5xx).",
+			},
+			"min_backoff": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				Description: "Minimum backoff, in seconds, before retrying a transient API error. Applies to HTTP 429 as well as 5xx: the vendored cloudflare-go client doesn't expose the Retry-After header, so a 429 backs off exponentially from this value rather than honoring the server's requested wait.",
+			},
+			"max_backoff": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     30,
+				Description: "Maximum backoff, in seconds, before retrying a transient API error.",
+			},
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"cloudflare_zone_lockdown": resourceCloudflareZoneLockdown(),
+			"cloudflare_ruleset":       resourceCloudflareRuleset(),
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			"cloudflare_zone_lockdown":  dataSourceCloudflareZoneLockdown(),
+			"cloudflare_zone_lockdowns": dataSourceCloudflareZoneLockdowns(),
+		},
+
+		ConfigureFunc: providerConfigure,
+	}
+}
+
+func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+	options := []cloudflare.Option{}
+
+	var api *cloudflare.API
+	var err error
+
+	if apiToken, ok := d.GetOk("api_token"); ok {
+		api, err = cloudflare.NewWithAPIToken(apiToken.(string), options...)
+	} else {
+		api, err = cloudflare.New(d.Get("api_key").(string), d.Get("email").(string), options...)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating Cloudflare client: %s", err)
+	}
+
+	maxRetries := d.Get("max_retries").(int)
+	minBackoff := time.Duration(d.Get("min_backoff").(int)) * time.Second
+	maxBackoff := time.Duration(d.Get("max_backoff").(int)) * time.Second
+
+	return newCloudflareClient(api, maxRetries, minBackoff, maxBackoff), nil
+}
@@ -0,0 +1,754 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceCloudflareRuleset() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudflareRulesetCreate,
+		Read:   resourceCloudflareRulesetRead,
+		Update: resourceCloudflareRulesetUpdate,
+		Delete: resourceCloudflareRulesetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudflareRulesetImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"kind": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"root", "zone", "custom", "managed"}, false),
+			},
+			"phase": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"http_request_firewall_custom",
+					"http_request_firewall_managed",
+					"http_request_dynamic_redirect",
+					"http_request_transform",
+					"http_ratelimit",
+				}, false),
+			},
+			"rules": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ref": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"expression": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"action": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"action_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"content": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"content_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"uri": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"origin": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+												"path": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"value":      {Type: schema.TypeString, Optional: true},
+															"expression": {Type: schema.TypeString, Optional: true},
+														},
+													},
+												},
+												"query": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"value":      {Type: schema.TypeString, Optional: true},
+															"expression": {Type: schema.TypeString, Optional: true},
+														},
+													},
+												},
+											},
+										},
+									},
+									"from_value": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"status_code": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+												"preserve_query_string": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+												"target_url": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"value":      {Type: schema.TypeString, Optional: true},
+															"expression": {Type: schema.TypeString, Optional: true},
+														},
+													},
+												},
+											},
+										},
+									},
+									"phases": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"rulesets": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: "Ruleset IDs to skip, for a \"skip\" action.",
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+									"products": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: "Managed product names to skip, for a \"skip\" action.",
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+									"rules": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: "Specific rules to skip within a ruleset, for a \"skip\" action.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"ruleset_id": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"rule_ids": {
+													Type:     schema.TypeList,
+													Required: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"ratelimit": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"characteristics": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"period": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+									"requests_per_period": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+									"mitigation_timeout": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"logging": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceCloudflareRulesetCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*cloudflareClient)
+
+	zoneID := d.Get("zone_id").(string)
+	accountID := d.Get("account_id").(string)
+
+	if zoneID == "" && accountID == "" {
+		return fmt.Errorf("either zone_id or account_id must be set")
+	}
+	if zoneID != "" && accountID != "" {
+		return fmt.Errorf("only one of zone_id or account_id may be set")
+	}
+
+	newRuleset := cloudflare.Ruleset{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Kind:        d.Get("kind").(string),
+		Phase:       d.Get("phase").(string),
+		Rules:       expandRulesetRules(d.Get("rules").([]interface{})),
+	}
+
+	log.Printf("[INFO] Creating Cloudflare Ruleset from struct: %+v", newRuleset)
+
+	var ruleset cloudflare.Ruleset
+	var err error
+
+	if zoneID != "" {
+		ruleset, err = client.CreateZoneRuleset(context.Background(), zoneID, newRuleset)
+	} else {
+		ruleset, err = client.CreateAccountRuleset(context.Background(), accountID, newRuleset)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error creating ruleset %q: %s", newRuleset.Name, err)
+	}
+
+	if ruleset.ID == "" {
+		return fmt.Errorf("failed to find id in Create response; resource was empty")
+	}
+
+	d.SetId(ruleset.ID)
+
+	log.Printf("[INFO] Cloudflare Ruleset ID: %s", d.Id())
+
+	return resourceCloudflareRulesetRead(d, meta)
+}
+
+func resourceCloudflareRulesetRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*cloudflareClient)
+
+	zoneID := d.Get("zone_id").(string)
+	accountID := d.Get("account_id").(string)
+
+	var ruleset cloudflare.Ruleset
+	var err error
+
+	if zoneID != "" {
+		ruleset, err = client.ZoneRuleset(context.Background(), zoneID, d.Id())
+	} else {
+		ruleset, err = client.AccountRuleset(context.Background(), accountID, d.Id())
+	}
+
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] Ruleset %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error finding ruleset %q: %s", d.Id(), err)
+	}
+
+	d.Set("name", ruleset.Name)
+	d.Set("description", ruleset.Description)
+	d.Set("kind", ruleset.Kind)
+	d.Set("phase", ruleset.Phase)
+
+	if err := d.Set("rules", flattenRulesetRules(d.Get("rules").([]interface{}), ruleset.Rules)); err != nil {
+		log.Printf("[WARN] Error setting rules in ruleset %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceCloudflareRulesetUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*cloudflareClient)
+
+	zoneID := d.Get("zone_id").(string)
+	accountID := d.Get("account_id").(string)
+
+	updatedRuleset := cloudflare.Ruleset{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Kind:        d.Get("kind").(string),
+		Phase:       d.Get("phase").(string),
+		Rules:       expandRulesetRules(d.Get("rules").([]interface{})),
+	}
+
+	log.Printf("[INFO] Updating Cloudflare Ruleset from struct: %+v", updatedRuleset)
+
+	var err error
+
+	if zoneID != "" {
+		_, err = client.UpdateZoneRuleset(context.Background(), zoneID, d.Id(), updatedRuleset)
+	} else {
+		_, err = client.UpdateAccountRuleset(context.Background(), accountID, d.Id(), updatedRuleset)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error updating ruleset %q: %s", d.Id(), err)
+	}
+
+	return resourceCloudflareRulesetRead(d, meta)
+}
+
+func resourceCloudflareRulesetDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*cloudflareClient)
+
+	zoneID := d.Get("zone_id").(string)
+	accountID := d.Get("account_id").(string)
+
+	log.Printf("[INFO] Deleting Cloudflare Ruleset: id %s", d.Id())
+
+	var err error
+
+	if zoneID != "" {
+		err = client.DeleteZoneRuleset(context.Background(), zoneID, d.Id())
+	} else {
+		err = client.DeleteAccountRuleset(context.Background(), accountID, d.Id())
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Cloudflare Ruleset: %s", err)
+	}
+
+	return nil
+}
+
+// expandRulesetRules converts the `rules` schema list into the cloudflare-go
+// representation, preserving any `ref` the user supplied so Update calls can
+// target a specific rule rather than replacing the whole set positionally.
+func expandRulesetRules(rules []interface{}) []cloudflare.RulesetRule {
+	rulesetRules := make([]cloudflare.RulesetRule, 0, len(rules))
+
+	for _, r := range rules {
+		rule := r.(map[string]interface{})
+
+		enabled := rule["enabled"].(bool)
+		rulesetRule := cloudflare.RulesetRule{
+			Ref:         rule["ref"].(string),
+			Expression:  rule["expression"].(string),
+			Action:      rule["action"].(string),
+			Description: rule["description"].(string),
+			Enabled:     &enabled,
+		}
+
+		if ap, ok := rule["action_parameters"].([]interface{}); ok && len(ap) == 1 {
+			rulesetRule.ActionParameters = expandRulesetRuleActionParameters(ap[0].(map[string]interface{}))
+		}
+
+		if rl, ok := rule["ratelimit"].([]interface{}); ok && len(rl) == 1 {
+			rulesetRule.RateLimit = expandRulesetRuleRateLimit(rl[0].(map[string]interface{}))
+		}
+
+		if lg, ok := rule["logging"].([]interface{}); ok && len(lg) == 1 {
+			logging := lg[0].(map[string]interface{})
+			rulesetRule.Logging = &cloudflare.RulesetRuleLogging{
+				Enabled: logging["enabled"].(bool),
+			}
+		}
+
+		rulesetRules = append(rulesetRules, rulesetRule)
+	}
+
+	return rulesetRules
+}
+
+func expandRulesetRuleActionParameters(ap map[string]interface{}) *cloudflare.RulesetRuleActionParameters {
+	params := &cloudflare.RulesetRuleActionParameters{
+		Content:     ap["content"].(string),
+		ContentType: ap["content_type"].(string),
+	}
+
+	if uri, ok := ap["uri"].([]interface{}); ok && len(uri) == 1 {
+		params.URI = expandRulesetRuleActionParametersURI(uri[0].(map[string]interface{}))
+	}
+
+	if fromValue, ok := ap["from_value"].([]interface{}); ok && len(fromValue) == 1 {
+		params.FromValue = expandRulesetRuleActionParametersFromValue(fromValue[0].(map[string]interface{}))
+	}
+
+	if phases, ok := ap["phases"].([]interface{}); ok {
+		params.Phases = expandInterfaceToStringList(phases)
+	}
+
+	if rulesets, ok := ap["rulesets"].([]interface{}); ok {
+		params.Rulesets = expandInterfaceToStringList(rulesets)
+	}
+
+	if products, ok := ap["products"].([]interface{}); ok {
+		params.Products = expandInterfaceToStringList(products)
+	}
+
+	if rules, ok := ap["rules"].([]interface{}); ok && len(rules) > 0 {
+		params.Rules = expandRulesetRuleActionParametersRules(rules)
+	}
+
+	return params
+}
+
+// expandRulesetRuleActionParametersURI builds the nested URI rewrite shape
+// the API expects: a path and/or query override, each either a literal
+// value or an expression, plus whether to rewrite the origin.
+func expandRulesetRuleActionParametersURI(uri map[string]interface{}) *cloudflare.RulesetRuleActionParametersURI {
+	params := &cloudflare.RulesetRuleActionParametersURI{
+		Origin: uri["origin"].(bool),
+	}
+
+	if path, ok := uri["path"].([]interface{}); ok && len(path) == 1 {
+		params.Path = expandRulesetRuleActionParametersURIElement(path[0].(map[string]interface{}))
+	}
+
+	if query, ok := uri["query"].([]interface{}); ok && len(query) == 1 {
+		params.Query = expandRulesetRuleActionParametersURIElement(query[0].(map[string]interface{}))
+	}
+
+	return params
+}
+
+func expandRulesetRuleActionParametersURIElement(element map[string]interface{}) *cloudflare.RulesetRuleActionParametersURIPath {
+	return &cloudflare.RulesetRuleActionParametersURIPath{
+		Value:      element["value"].(string),
+		Expression: element["expression"].(string),
+	}
+}
+
+// expandRulesetRuleActionParametersFromValue builds the dynamic redirect
+// action's parameters: the target URL (literal or expression), the response
+// status code, and whether to preserve the incoming query string.
+func expandRulesetRuleActionParametersFromValue(fromValue map[string]interface{}) *cloudflare.RulesetRuleActionParametersFromValue {
+	params := &cloudflare.RulesetRuleActionParametersFromValue{
+		StatusCode:          uint16(fromValue["status_code"].(int)),
+		PreserveQueryString: fromValue["preserve_query_string"].(bool),
+	}
+
+	if targetURL, ok := fromValue["target_url"].([]interface{}); ok && len(targetURL) == 1 {
+		tu := targetURL[0].(map[string]interface{})
+		params.TargetURL = cloudflare.RulesetRuleActionParametersTargetURL{
+			Value:      tu["value"].(string),
+			Expression: tu["expression"].(string),
+		}
+	}
+
+	return params
+}
+
+// expandRulesetRuleActionParametersRules builds the "skip" action's
+// rules map, keyed by the ruleset id being skipped, from the
+// ruleset_id/rule_ids pairs in the schema.
+func expandRulesetRuleActionParametersRules(rules []interface{}) map[string][]string {
+	result := make(map[string][]string, len(rules))
+
+	for _, r := range rules {
+		entry := r.(map[string]interface{})
+		rulesetID := entry["ruleset_id"].(string)
+		result[rulesetID] = expandInterfaceToStringList(entry["rule_ids"].([]interface{}))
+	}
+
+	return result
+}
+
+func expandRulesetRuleRateLimit(rl map[string]interface{}) *cloudflare.RulesetRuleRateLimit {
+	rateLimit := &cloudflare.RulesetRuleRateLimit{
+		Period:            rl["period"].(int),
+		RequestsPerPeriod: rl["requests_per_period"].(int),
+		MitigationTimeout: rl["mitigation_timeout"].(int),
+	}
+
+	if characteristics, ok := rl["characteristics"].([]interface{}); ok {
+		rateLimit.Characteristics = expandInterfaceToStringList(characteristics)
+	}
+
+	return rateLimit
+}
+
+// flattenRulesetRules reads the API's rules back into schema form, keyed by
+// the API-returned `id`/`ref` against the previously configured order so
+// that a ruleset whose rules come back in the same set (just possibly
+// reordered or re-numbered by the API) doesn't churn in Terraform: rules
+// present in `configured` keep their prior relative order, and any rule the
+// API returned that wasn't in `configured` (e.g. newly created) is appended
+// in API order.
+func flattenRulesetRules(configured []interface{}, rules []cloudflare.RulesetRule) []map[string]interface{} {
+	flattened := flattenRulesetRulesByAPIOrder(rules)
+	return reorderFlattenedRulesetRules(configured, flattened)
+}
+
+func flattenRulesetRulesByAPIOrder(rules []cloudflare.RulesetRule) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, len(rules))
+
+	for i, rule := range rules {
+		enabled := true
+		if rule.Enabled != nil {
+			enabled = *rule.Enabled
+		}
+
+		entry := map[string]interface{}{
+			"id":          rule.ID,
+			"ref":         rule.Ref,
+			"expression":  rule.Expression,
+			"action":      rule.Action,
+			"description": rule.Description,
+			"enabled":     enabled,
+		}
+
+		if rule.ActionParameters != nil {
+			entry["action_parameters"] = []map[string]interface{}{
+				{
+					"content":      rule.ActionParameters.Content,
+					"content_type": rule.ActionParameters.ContentType,
+					"uri":          flattenRulesetRuleActionParametersURI(rule.ActionParameters.URI),
+					"from_value":   flattenRulesetRuleActionParametersFromValue(rule.ActionParameters.FromValue),
+					"phases":       rule.ActionParameters.Phases,
+					"rulesets":     rule.ActionParameters.Rulesets,
+					"products":     rule.ActionParameters.Products,
+					"rules":        flattenRulesetRuleActionParametersRules(rule.ActionParameters.Rules),
+				},
+			}
+		}
+
+		if rule.RateLimit != nil {
+			entry["ratelimit"] = []map[string]interface{}{
+				{
+					"characteristics":     rule.RateLimit.Characteristics,
+					"period":              rule.RateLimit.Period,
+					"requests_per_period": rule.RateLimit.RequestsPerPeriod,
+					"mitigation_timeout":  rule.RateLimit.MitigationTimeout,
+				},
+			}
+		}
+
+		if rule.Logging != nil {
+			entry["logging"] = []map[string]interface{}{
+				{"enabled": rule.Logging.Enabled},
+			}
+		}
+
+		flattened[i] = entry
+	}
+
+	return flattened
+}
+
+func flattenRulesetRuleActionParametersURI(uri *cloudflare.RulesetRuleActionParametersURI) []map[string]interface{} {
+	if uri == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"origin": uri.Origin,
+			"path":   flattenRulesetRuleActionParametersURIElement(uri.Path),
+			"query":  flattenRulesetRuleActionParametersURIElement(uri.Query),
+		},
+	}
+}
+
+func flattenRulesetRuleActionParametersURIElement(element *cloudflare.RulesetRuleActionParametersURIPath) []map[string]interface{} {
+	if element == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"value":      element.Value,
+			"expression": element.Expression,
+		},
+	}
+}
+
+func flattenRulesetRuleActionParametersFromValue(fromValue *cloudflare.RulesetRuleActionParametersFromValue) []map[string]interface{} {
+	if fromValue == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"status_code":           int(fromValue.StatusCode),
+			"preserve_query_string": fromValue.PreserveQueryString,
+			"target_url": []map[string]interface{}{
+				{
+					"value":      fromValue.TargetURL.Value,
+					"expression": fromValue.TargetURL.Expression,
+				},
+			},
+		},
+	}
+}
+
+// flattenRulesetRuleActionParametersRules flattens the "skip" action's rules
+// map back into ruleset_id/rule_ids pairs, sorted by ruleset_id so the
+// result doesn't churn across reads just because Go randomized map order.
+func flattenRulesetRuleActionParametersRules(rules map[string][]string) []map[string]interface{} {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	rulesetIDs := make([]string, 0, len(rules))
+	for rulesetID := range rules {
+		rulesetIDs = append(rulesetIDs, rulesetID)
+	}
+	sort.Strings(rulesetIDs)
+
+	flattened := make([]map[string]interface{}, 0, len(rulesetIDs))
+	for _, rulesetID := range rulesetIDs {
+		flattened = append(flattened, map[string]interface{}{
+			"ruleset_id": rulesetID,
+			"rule_ids":   rules[rulesetID],
+		})
+	}
+
+	return flattened
+}
+
+// rulesetRuleKey returns the identity an API-returned rule is matched
+// against a previously configured one by: its `id` if present, else its
+// `ref`. Rules with neither (shouldn't happen once created, but possible on
+// a freshly-applied managed ruleset) never match and are treated as new.
+func rulesetRuleKey(entry map[string]interface{}) (string, bool) {
+	if id, _ := entry["id"].(string); id != "" {
+		return "id:" + id, true
+	}
+	if ref, _ := entry["ref"].(string); ref != "" {
+		return "ref:" + ref, true
+	}
+	return "", false
+}
+
+// reorderFlattenedRulesetRules re-sorts apiOrder to match the relative order
+// of configured, matching entries by rulesetRuleKey. Entries from apiOrder
+// with no match in configured are appended at the end in their original
+// order.
+func reorderFlattenedRulesetRules(configured []interface{}, apiOrder []map[string]interface{}) []map[string]interface{} {
+	byKey := make(map[string]map[string]interface{}, len(apiOrder))
+	used := make(map[string]bool, len(apiOrder))
+
+	for _, entry := range apiOrder {
+		if key, ok := rulesetRuleKey(entry); ok {
+			byKey[key] = entry
+		}
+	}
+
+	reordered := make([]map[string]interface{}, 0, len(apiOrder))
+
+	for _, c := range configured {
+		config, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		key, ok := rulesetRuleKey(config)
+		if !ok {
+			continue
+		}
+
+		if entry, found := byKey[key]; found && !used[key] {
+			reordered = append(reordered, entry)
+			used[key] = true
+		}
+	}
+
+	for _, entry := range apiOrder {
+		key, ok := rulesetRuleKey(entry)
+		if ok && used[key] {
+			continue
+		}
+		reordered = append(reordered, entry)
+	}
+
+	return reordered
+}
+
+func resourceCloudflareRulesetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idAttr := strings.SplitN(d.Id(), "/", 3)
+
+	if len(idAttr) != 3 {
+		return nil, fmt.Errorf("invalid id (%q) specified, should be in format \"{zone|account}/{scope_id}/{ruleset_id}\"", d.Id())
+	}
+
+	scopeType, scopeID, rulesetID := idAttr[0], idAttr[1], idAttr[2]
+
+	switch scopeType {
+	case "zone":
+		d.Set("zone_id", scopeID)
+	case "account":
+		d.Set("account_id", scopeID)
+	default:
+		return nil, fmt.Errorf("invalid scope (%q) specified, expected \"zone\" or \"account\"", scopeType)
+	}
+
+	d.SetId(rulesetID)
+
+	log.Printf("[DEBUG] Importing Cloudflare Ruleset: scope %s/%s, id %s", scopeType, scopeID, rulesetID)
+
+	return []*schema.ResourceData{d}, nil
+}
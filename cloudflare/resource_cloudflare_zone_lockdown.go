@@ -1,8 +1,12 @@
 package cloudflare
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net"
+	"regexp"
+	"strconv"
 	"strings"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
@@ -10,6 +14,45 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 )
 
+var zoneLockdownASNRe = regexp.MustCompile(`^AS[0-9]+$`)
+
+// zoneLockdownCountryCodes is the set of ISO-3166 alpha-2 country codes
+// accepted by the lockdown/firewall "country" target.
+var zoneLockdownCountryCodes = map[string]bool{
+	"AD": true, "AE": true, "AF": true, "AG": true, "AI": true, "AL": true, "AM": true, "AO": true,
+	"AQ": true, "AR": true, "AS": true, "AT": true, "AU": true, "AW": true, "AX": true, "AZ": true,
+	"BA": true, "BB": true, "BD": true, "BE": true, "BF": true, "BG": true, "BH": true, "BI": true,
+	"BJ": true, "BL": true, "BM": true, "BN": true, "BO": true, "BQ": true, "BR": true, "BS": true,
+	"BT": true, "BV": true, "BW": true, "BY": true, "BZ": true, "CA": true, "CC": true, "CD": true,
+	"CF": true, "CG": true, "CH": true, "CI": true, "CK": true, "CL": true, "CM": true, "CN": true,
+	"CO": true, "CR": true, "CU": true, "CV": true, "CW": true, "CX": true, "CY": true, "CZ": true,
+	"DE": true, "DJ": true, "DK": true, "DM": true, "DO": true, "DZ": true, "EC": true, "EE": true,
+	"EG": true, "EH": true, "ER": true, "ES": true, "ET": true, "FI": true, "FJ": true, "FK": true,
+	"FM": true, "FO": true, "FR": true, "GA": true, "GB": true, "GD": true, "GE": true, "GF": true,
+	"GG": true, "GH": true, "GI": true, "GL": true, "GM": true, "GN": true, "GP": true, "GQ": true,
+	"GR": true, "GS": true, "GT": true, "GU": true, "GW": true, "GY": true, "HK": true, "HM": true,
+	"HN": true, "HR": true, "HT": true, "HU": true, "ID": true, "IE": true, "IL": true, "IM": true,
+	"IN": true, "IO": true, "IQ": true, "IR": true, "IS": true, "IT": true, "JE": true, "JM": true,
+	"JO": true, "JP": true, "KE": true, "KG": true, "KH": true, "KI": true, "KM": true, "KN": true,
+	"KP": true, "KR": true, "KW": true, "KY": true, "KZ": true, "LA": true, "LB": true, "LC": true,
+	"LI": true, "LK": true, "LR": true, "LS": true, "LT": true, "LU": true, "LV": true, "LY": true,
+	"MA": true, "MC": true, "MD": true, "ME": true, "MF": true, "MG": true, "MH": true, "MK": true,
+	"ML": true, "MM": true, "MN": true, "MO": true, "MP": true, "MQ": true, "MR": true, "MS": true,
+	"MT": true, "MU": true, "MV": true, "MW": true, "MX": true, "MY": true, "MZ": true, "NA": true,
+	"NC": true, "NE": true, "NF": true, "NG": true, "NI": true, "NL": true, "NO": true, "NP": true,
+	"NR": true, "NU": true, "NZ": true, "OM": true, "PA": true, "PE": true, "PF": true, "PG": true,
+	"PH": true, "PK": true, "PL": true, "PM": true, "PN": true, "PR": true, "PS": true, "PT": true,
+	"PW": true, "PY": true, "QA": true, "RE": true, "RO": true, "RS": true, "RU": true, "RW": true,
+	"SA": true, "SB": true, "SC": true, "SD": true, "SE": true, "SG": true, "SH": true, "SI": true,
+	"SJ": true, "SK": true, "SL": true, "SM": true, "SN": true, "SO": true, "SR": true, "SS": true,
+	"ST": true, "SV": true, "SX": true, "SY": true, "SZ": true, "TC": true, "TD": true, "TF": true,
+	"TG": true, "TH": true, "TJ": true, "TK": true, "TL": true, "TM": true, "TN": true, "TO": true,
+	"TR": true, "TT": true, "TV": true, "TW": true, "TZ": true, "UA": true, "UG": true, "UM": true,
+	"US": true, "UY": true, "UZ": true, "VA": true, "VC": true, "VE": true, "VG": true, "VI": true,
+	"VN": true, "VU": true, "WF": true, "WS": true, "YE": true, "YT": true, "ZA": true, "ZM": true,
+	"ZW": true,
+}
+
 func resourceCloudflareZoneLockdown() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceCloudflareZoneLockdownCreate,
@@ -55,21 +98,113 @@ func resourceCloudflareZoneLockdown() *schema.Resource {
 						"target": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validation.StringInSlice([]string{"ip", "ip_range"}, false),
+							ValidateFunc: validation.StringInSlice([]string{"ip", "ip_range", "ip6", "ip6_range", "asn", "country"}, false),
 						},
 						"value": {
 							Type:     schema.TypeString,
 							Required: true,
+							// configurations is a TypeSet, so each element is
+							// identified by a hash of its raw field values - a
+							// DiffSuppressFunc never even gets consulted unless
+							// the set already considers two elements a match.
+							// StateFunc runs before that hash is computed, so
+							// normalizing here is what actually makes a
+							// non-canonical config value (e.g. a lowercase
+							// country code) converge on the same set member as
+							// the canonical value Read puts in state.
+							StateFunc: func(val interface{}) string {
+								return normalizeZoneLockdownConfigurationValue(val.(string))
+							},
 						},
 					},
 				},
 			},
 		},
+
+		CustomizeDiff: resourceCloudflareZoneLockdownCustomizeDiff,
+	}
+}
+
+// resourceCloudflareZoneLockdownCustomizeDiff validates that each
+// configuration's "value" is well-formed for its "target", since the schema
+// package has no way to cross-validate two fields of the same set element.
+func resourceCloudflareZoneLockdownCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	configurations, ok := d.Get("configurations").(*schema.Set)
+	if !ok {
+		return nil
+	}
+
+	for _, entry := range configurations.List() {
+		config := entry.(map[string]interface{})
+		if err := validateZoneLockdownConfigurationValue(config["target"].(string), config["value"].(string)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateZoneLockdownConfigurationValue(target, value string) error {
+	switch target {
+	case "ip":
+		if ip := net.ParseIP(value); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("%q is not a valid IPv4 address for target \"ip\"", value)
+		}
+	case "ip_range":
+		ip, _, err := net.ParseCIDR(value)
+		if err != nil || ip.To4() == nil {
+			return fmt.Errorf("%q is not a valid IPv4 CIDR range for target \"ip_range\"", value)
+		}
+	case "ip6":
+		ip := net.ParseIP(value)
+		if ip == nil || ip.To4() != nil {
+			return fmt.Errorf("%q is not a valid IPv6 address for target \"ip6\"", value)
+		}
+	case "ip6_range":
+		ip, _, err := net.ParseCIDR(value)
+		if err != nil || ip.To4() != nil {
+			return fmt.Errorf("%q is not a valid IPv6 CIDR range for target \"ip6_range\"", value)
+		}
+	case "asn":
+		if !zoneLockdownASNRe.MatchString(strings.ToUpper(value)) {
+			return fmt.Errorf("%q is not a valid ASN for target \"asn\"; expected a value like \"AS64512\"", value)
+		}
+		if _, err := strconv.Atoi(strings.TrimPrefix(strings.ToUpper(value), "AS")); err != nil {
+			return fmt.Errorf("%q is not a valid ASN for target \"asn\": %s", value, err)
+		}
+	case "country":
+		if !zoneLockdownCountryCodes[strings.ToUpper(value)] {
+			return fmt.Errorf("%q is not a valid ISO-3166 alpha-2 country code for target \"country\"", value)
+		}
+	}
+
+	return nil
+}
+
+// normalizeZoneLockdownConfigurationValue canonicalizes a configuration
+// value so case or CIDR formatting differences don't produce spurious
+// diffs. It infers the value's shape rather than taking the target as a
+// parameter, since it also backs the "value" field's StateFunc, which is
+// only ever given the value itself.
+func normalizeZoneLockdownConfigurationValue(value string) string {
+	if _, ipNet, err := net.ParseCIDR(value); err == nil {
+		return ipNet.String()
+	}
+	if ip := net.ParseIP(value); ip != nil {
+		return ip.String()
+	}
+	if zoneLockdownASNRe.MatchString(strings.ToUpper(value)) {
+		return strings.ToUpper(value)
 	}
+	if zoneLockdownCountryCodes[strings.ToUpper(value)] {
+		return strings.ToUpper(value)
+	}
+
+	return value
 }
 
 func resourceCloudflareZoneLockdownCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client := meta.(*cloudflareClient)
 	zone := d.Get("zone").(string)
 
 	zoneID, err := client.ZoneIDByName(zone)
@@ -100,7 +235,7 @@ func resourceCloudflareZoneLockdownCreate(d *schema.ResourceData, meta interface
 
 	var r *cloudflare.ZoneLockdownResponse
 
-	r, err = client.CreateZoneLockdown(zoneID, newZoneLockdown)
+	r, err = client.CreateZoneLockdown(context.Background(), zoneID, newZoneLockdown)
 
 	if err != nil {
 		return fmt.Errorf("error creating zone lockdown for zone %q: %s", zone, err)
@@ -118,17 +253,17 @@ func resourceCloudflareZoneLockdownCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceCloudflareZoneLockdownRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client := meta.(*cloudflareClient)
 	zoneID := d.Get("zone_id").(string)
 
 	log.Printf("[DEBUG] zoneID: %s", zoneID)
-	zoneLockdownResponse, err := client.ZoneLockdown(zoneID, d.Id())
+	zoneLockdownResponse, err := client.ZoneLockdown(context.Background(), zoneID, d.Id())
 
 	log.Printf("[DEBUG] zoneLockdownResponse: %#v", zoneLockdownResponse)
 	log.Printf("[DEBUG] zoneLockdownResponse error: %#v", err)
 
 	if err != nil {
-		if strings.Contains(err.Error(), "HTTP status 404") {
+		if IsNotFound(err) {
 			log.Printf("[INFO] Zone Lockdown %s no longer exists", d.Id())
 			d.SetId("")
 			return nil
@@ -148,7 +283,7 @@ func resourceCloudflareZoneLockdownRead(d *schema.ResourceData, meta interface{}
 	for i, entryconfigZoneLockdownConfig := range zoneLockdownResponse.Result.Configurations {
 		configurations[i] = map[string]interface{}{
 			"target": entryconfigZoneLockdownConfig.Target,
-			"value":  entryconfigZoneLockdownConfig.Value,
+			"value":  normalizeZoneLockdownConfigurationValue(entryconfigZoneLockdownConfig.Value),
 		}
 	}
 	log.Printf("[DEBUG] Cloudflare Zone Lockdown configuration: %#v", configurations)
@@ -161,52 +296,38 @@ func resourceCloudflareZoneLockdownRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceCloudflareZoneLockdownUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client := meta.(*cloudflareClient)
 	zoneID := d.Get("zone_id").(string)
 
-	newRule := cloudflare.AccessRule{
-		Notes: d.Get("notes").(string),
-		Mode:  d.Get("mode").(string),
+	// UpdateZoneLockdown is a PUT of the whole lockdown (no omitempty on
+	// these fields), so the full desired state has to be sent on every call
+	// regardless of which fields actually changed - sending only the
+	// changed ones would null out the rest server-side.
+	updatedZoneLockdown := cloudflare.ZoneLockdown{
+		Paused:         d.Get("paused").(bool),
+		Description:    d.Get("description").(string),
+		URLs:           expandInterfaceToStringList(d.Get("urls").(*schema.Set).List()),
+		Configurations: expandZoneLockdownConfig(d.Get("configurations").(*schema.Set)),
 	}
 
-	if configuration, configurationOk := d.GetOk("configuration"); configurationOk {
-		config := configuration.(map[string]interface{})
+	log.Printf("[INFO] Updating Cloudflare Zone Lockdown from struct: %+v", updatedZoneLockdown)
 
-		newRule.Configuration = cloudflare.AccessRuleConfiguration{
-			Target: config["target"].(string),
-			Value:  config["value"].(string),
-		}
-	}
-
-	// var accessRuleResponse *cloudflare.AccessRuleResponse
-	var err error
-
-	if zoneID == "" {
-		if client.OrganizationID != "" {
-			_, err = client.UpdateOrganizationAccessRule(client.OrganizationID, d.Id(), newRule)
-		} else {
-			_, err = client.UpdateUserAccessRule(d.Id(), newRule)
-		}
-	} else {
-		_, err = client.UpdateZoneAccessRule(zoneID, d.Id(), newRule)
-	}
-
-	if err != nil {
-		return fmt.Errorf("Failed to update Access Rule: %s", err)
+	if _, err := client.UpdateZoneLockdown(context.Background(), zoneID, d.Id(), updatedZoneLockdown); err != nil {
+		return fmt.Errorf("error updating zone lockdown for zone %q: %s", zoneID, err)
 	}
 
 	return resourceCloudflareZoneLockdownRead(d, meta)
 }
 
 func resourceCloudflareZoneLockdownDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+	client := meta.(*cloudflareClient)
 	zoneID := d.Get("zone_id").(string)
 
 	log.Printf("[INFO] Deleting Cloudflare Zone Lockdown: id %s for zone_id %s", d.Id(), zoneID)
 
 	var err error
 
-	_, err = client.DeleteZoneLockdown(zoneID, d.Id())
+	_, err = client.DeleteZoneLockdown(context.Background(), zoneID, d.Id())
 
 	if err != nil {
 		return fmt.Errorf("Error deleting Cloudflare Zone Lockdown: %s", err)
@@ -228,7 +349,7 @@ func expandZoneLockdownConfig(configs *schema.Set) []cloudflare.ZoneLockdownConf
 }
 
 func resourceCloudflareZoneLockdownImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	client := meta.(*cloudflare.API)
+	client := meta.(*cloudflareClient)
 
 	// split the id so we can lookup
 	idAttr := strings.SplitN(d.Id(), "/", 2)
@@ -0,0 +1,205 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccCloudflareZoneLockdownUpdate_Description(t *testing.T) {
+	var lockdown cloudflare.ZoneLockdown
+	rnd := generateRandomResourceName()
+	name := "cloudflare_zone_lockdown." + rnd
+	zone := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudflareZoneLockdownDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareZoneLockdownConfig(zone, rnd, "initial description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareZoneLockdownExists(name, &lockdown),
+					resource.TestCheckResourceAttr(name, "description", "initial description"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareZoneLockdownConfig(zone, rnd, "updated description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareZoneLockdownExists(name, &lockdown),
+					resource.TestCheckResourceAttr(name, "description", "updated description"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareZoneLockdownUpdate_URLs(t *testing.T) {
+	var lockdown cloudflare.ZoneLockdown
+	rnd := generateRandomResourceName()
+	name := "cloudflare_zone_lockdown." + rnd
+	zone := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudflareZoneLockdownDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareZoneLockdownConfigWithURLs(zone, rnd, []string{"example.com/admin"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareZoneLockdownExists(name, &lockdown),
+					resource.TestCheckResourceAttr(name, "urls.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareZoneLockdownConfigWithURLs(zone, rnd, []string{"example.com/admin", "example.com/wp-admin"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareZoneLockdownExists(name, &lockdown),
+					resource.TestCheckResourceAttr(name, "urls.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareZoneLockdownUpdate_Configurations(t *testing.T) {
+	var lockdown cloudflare.ZoneLockdown
+	rnd := generateRandomResourceName()
+	name := "cloudflare_zone_lockdown." + rnd
+	zone := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudflareZoneLockdownDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareZoneLockdownConfigWithTarget(zone, rnd, "ip", "192.0.2.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareZoneLockdownExists(name, &lockdown),
+					resource.TestCheckResourceAttr(name, "configurations.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareZoneLockdownConfigWithTarget(zone, rnd, "ip_range", "198.51.100.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareZoneLockdownExists(name, &lockdown),
+					resource.TestCheckResourceAttr(name, "configurations.#", "1"),
+					testAccCheckCloudflareZoneLockdownConfiguration(&lockdown, "ip_range", "198.51.100.0/24"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareZoneLockdownDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflareClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_zone_lockdown" {
+			continue
+		}
+
+		_, err := client.ZoneLockdown(context.Background(), rs.Primary.Attributes["zone_id"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("zone lockdown still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckCloudflareZoneLockdownExists(n string, lockdown *cloudflare.ZoneLockdown) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no zone lockdown ID is set")
+		}
+
+		client := testAccProvider.Meta().(*cloudflareClient)
+		foundLockdown, err := client.ZoneLockdown(context.Background(), rs.Primary.Attributes["zone_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*lockdown = foundLockdown.Result
+		return nil
+	}
+}
+
+// testAccCheckCloudflareZoneLockdownConfiguration checks the single
+// configuration of the lockdown fetched from the API. configurations is a
+// set, so its state keys are element hashes rather than list indices.
+func testAccCheckCloudflareZoneLockdownConfiguration(lockdown *cloudflare.ZoneLockdown, target, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(lockdown.Configurations) != 1 {
+			return fmt.Errorf("expected 1 configuration, got %d", len(lockdown.Configurations))
+		}
+		if c := lockdown.Configurations[0]; c.Target != target || c.Value != value {
+			return fmt.Errorf("expected configuration %s=%s, got %s=%s", target, value, c.Target, c.Value)
+		}
+		return nil
+	}
+}
+
+func testAccCheckCloudflareZoneLockdownConfig(zone, rnd, description string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zone_lockdown" "%[2]s" {
+  zone        = "%[1]s"
+  paused      = false
+  description = "%[3]s"
+  urls        = ["%[1]s/example"]
+
+  configurations {
+    target = "ip"
+    value  = "192.0.2.1"
+  }
+}`, zone, rnd, description)
+}
+
+func testAccCheckCloudflareZoneLockdownConfigWithURLs(zone, rnd string, urls []string) string {
+	quoted := make([]string, len(urls))
+	for i, u := range urls {
+		quoted[i] = fmt.Sprintf("%q", u)
+	}
+
+	return fmt.Sprintf(`
+resource "cloudflare_zone_lockdown" "%[2]s" {
+  zone        = "%[1]s"
+  paused      = false
+  description = "acceptance test"
+  urls        = [%[3]s]
+
+  configurations {
+    target = "ip"
+    value  = "192.0.2.1"
+  }
+}`, zone, rnd, strings.Join(quoted, ", "))
+}
+
+func testAccCheckCloudflareZoneLockdownConfigWithTarget(zone, rnd, target, value string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zone_lockdown" "%[2]s" {
+  zone        = "%[1]s"
+  paused      = false
+  description = "acceptance test"
+  urls        = ["%[1]s/example"]
+
+  configurations {
+    target = "%[3]s"
+    value  = "%[4]s"
+  }
+}`, zone, rnd, target, value)
+}